@@ -0,0 +1,27 @@
+package ec2
+
+import (
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpcendpoint"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpcpeeringconnection"
+)
+
+// SetupEC2 creates all EC2 API related controllers with the supplied logger
+// and adds them to the supplied manager.
+func SetupEC2(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, enableManagementPolicies bool) error {
+	for _, setup := range []func(ctrl.Manager, logging.Logger, workqueue.RateLimiter, time.Duration, bool) error{
+		vpcendpoint.SetupVPCEndpoint,
+		vpcpeeringconnection.SetupVPCPeeringConnection,
+	} {
+		if err := setup(mgr, l, rl, poll, enableManagementPolicies); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,6 +2,10 @@ package vpcendpoint
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,12 +35,13 @@ import (
 )
 
 // SetupVPCEndpoint adds a controller that reconciles VPCEndpoint.
-func SetupVPCEndpoint(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+func SetupVPCEndpoint(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, enableManagementPolicies bool) error {
 	name := managed.ControllerName(svcapitypes.VPCEndpointGroupKind)
 	opts := []option{
 		func(e *external) {
 			c := &custom{client: e.client, kube: e.kube}
 			e.delete = c.delete
+			e.update = c.update
 			e.preCreate = preCreate
 			e.postCreate = postCreate
 			e.postObserve = postObserve
@@ -44,6 +49,17 @@ func SetupVPCEndpoint(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimit
 			e.filterList = filterList
 		},
 	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		managed.WithPollInterval(poll),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+	if enableManagementPolicies {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
@@ -52,10 +68,7 @@ func SetupVPCEndpoint(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimit
 		For(&svcapitypes.VPCEndpoint{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.VPCEndpointGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(poll),
-			managed.WithLogger(l.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+			reconcilerOpts...))
 }
 
 type custom struct {
@@ -111,12 +124,7 @@ func postObserve(_ context.Context, cr *svcapitypes.VPCEndpoint, resp *svcsdk.De
 		return managed.ExternalObservation{}, err
 	}
 
-	// Load DNS Entry as connection detail
-	if len(resp.VpcEndpoints[0].DnsEntries) != 0 && awsclients.StringValue(resp.VpcEndpoints[0].DnsEntries[0].DnsName) != "" {
-		obs.ConnectionDetails = managed.ConnectionDetails{
-			xpv1.ResourceCredentialsSecretEndpointKey: []byte(awsclients.StringValue(resp.VpcEndpoints[0].DnsEntries[0].DnsName)),
-		}
-	}
+	obs.ConnectionDetails = connectionDetails(cr, resp.VpcEndpoints[0])
 
 	cr.Status.AtProvider.VPCEndpoint = generateVPCEndpointSDK(resp.VpcEndpoints[0])
 
@@ -134,6 +142,66 @@ func postObserve(_ context.Context, cr *svcapitypes.VPCEndpoint, resp *svcsdk.De
 	return obs, nil
 }
 
+/*
+azInDNSName matches the Availability Zone embedded in a zonal VPC endpoint
+DNS name, e.g. the "us-east-1a" in
+"vpce-0123456789abcdef0-abcdefgh-us-east-1a.vpce-svc-xxxx.us-east-1.vpce.amazonaws.com",
+or the "us-gov-west-1a" in a GovCloud DNS name. The regional DNS name for
+the same endpoint has no such suffix. Anchoring on a hyphen or the start
+of the hostname label keeps multi-hyphen partitions (us-gov-*, cn-north-*)
+from matching only their last two segments.
+*/
+var azInDNSName = regexp.MustCompile(`(?:^|-)([a-z]{2}(?:-[a-z]+){1,2}-\d[a-z])(?:\.|$)`)
+
+/*
+connectionDetails emits every DNS entry AWS returns for the VpcEndpoint -
+the regional entry plus one per Availability Zone for Interface
+endpoints - under AZ-suffixed keys (`endpoint`, `endpoint.us-east-1a`,
+`hostedZoneID`, `hostedZoneID.us-east-1a`, ...), along with
+`serviceName` and, when private DNS is enabled, `privateDnsName`. Keys
+are derived from the DNS name itself rather than its position in
+DnsEntries, since the EC2 API does not guarantee that list's ordering is
+stable across calls. Every key is namespaced with
+ConnectionDetailKeyPrefix when set, so multiple VPCEndpoints can be
+written into a single connection secret without colliding.
+*/
+func connectionDetails(cr *svcapitypes.VPCEndpoint, vpce *ec2.VpcEndpoint) managed.ConnectionDetails {
+	prefix := awsclients.StringValue(cr.Spec.ForProvider.ConnectionDetailKeyPrefix)
+
+	details := managed.ConnectionDetails{}
+	var firstDNSName string
+	for _, entry := range vpce.DnsEntries {
+		dnsName := awsclients.StringValue(entry.DnsName)
+		if dnsName == "" {
+			continue
+		}
+		if firstDNSName == "" {
+			firstDNSName = dnsName
+		}
+
+		endpointKey := string(xpv1.ResourceCredentialsSecretEndpointKey)
+		hostedZoneKey := "hostedZoneID"
+		if m := azInDNSName.FindStringSubmatch(dnsName); m != nil {
+			endpointKey = fmt.Sprintf("%s.%s", endpointKey, m[1])
+			hostedZoneKey = fmt.Sprintf("%s.%s", hostedZoneKey, m[1])
+		}
+
+		details[prefix+endpointKey] = []byte(dnsName)
+		if hostedZoneID := awsclients.StringValue(entry.HostedZoneId); hostedZoneID != "" {
+			details[prefix+hostedZoneKey] = []byte(hostedZoneID)
+		}
+	}
+
+	if awsclients.StringValue(vpce.ServiceName) != "" {
+		details[prefix+"serviceName"] = []byte(awsclients.StringValue(vpce.ServiceName))
+	}
+	if aws.BoolValue(vpce.PrivateDnsEnabled) && firstDNSName != "" {
+		details[prefix+"privateDnsName"] = []byte(firstDNSName)
+	}
+
+	return details
+}
+
 /*
 isUpToDate checks for the following mutable fields for the VPCEndpoint in upstream AWS:
 1. Subnets
@@ -178,19 +246,59 @@ sgCompare:
 	/*
 		4. Check policyDocument
 	*/
-	defaultPolicy := "{\"Statement\":[{\"Action\":\"*\",\"Effect\": \"Allow\",\"Principal\":\"*\",\"Resource\":\"*\"}]}"
 	declaredPolicy := awsclients.StringValue(cr.Spec.ForProvider.PolicyDocument)
 	upstreamPolicy := awsclients.StringValue(obj.VpcEndpoints[0].PolicyDocument)
 
-	// If no declared policy, we expect the result to be equivalent to the default policy
+	return policyIsUpToDate(declaredPolicy, upstreamPolicy), nil
+}
+
+/*
+defaultVPCEndpointPolicy is the policy document the EC2 API applies to a
+VPC endpoint when none is supplied on creation.
+*/
+const defaultVPCEndpointPolicy = `{"Statement":[{"Action":"*","Effect":"Allow","Principal":"*","Resource":"*"}]}`
+
+/*
+policyIsUpToDate compares a declared policy document against the upstream
+policy document returned by the EC2 API. An empty declaredPolicy means the
+caller did not specify one, in which case upstream is expected to be
+equivalent to (or a superset of) the implicit default policy.
+*/
+func policyIsUpToDate(declaredPolicy, upstreamPolicy string) bool {
 	if declaredPolicy == "" {
-		difference, _ := jsondiff.Compare([]byte(upstreamPolicy), []byte(defaultPolicy), &jsondiff.Options{})
-		return difference == jsondiff.FullMatch || difference == jsondiff.SupersetMatch, nil
+		difference, _ := jsondiff.Compare([]byte(canonicalizePolicy(upstreamPolicy)), []byte(canonicalizePolicy(defaultVPCEndpointPolicy)), &jsondiff.Options{})
+		return difference == jsondiff.FullMatch || difference == jsondiff.SupersetMatch
 	}
 
-	// If there is a declared policy, we expect the upstream policy to match
-	difference, _ := jsondiff.Compare([]byte(upstreamPolicy), []byte(declaredPolicy), &jsondiff.Options{})
-	return difference == jsondiff.FullMatch, nil
+	difference, _ := jsondiff.Compare([]byte(canonicalizePolicy(upstreamPolicy)), []byte(canonicalizePolicy(declaredPolicy)), &jsondiff.Options{})
+	return difference == jsondiff.FullMatch
+}
+
+/*
+canonicalizePolicy normalizes an IAM policy document so that semantically
+equivalent policies compare as equal regardless of whitespace, key
+ordering, or whether Statement is expressed as an object or a
+single-element array of objects.
+*/
+func canonicalizePolicy(policy string) string {
+	if strings.TrimSpace(policy) == "" {
+		return ""
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return policy
+	}
+
+	if stmt, ok := doc["Statement"].(map[string]interface{}); ok {
+		doc["Statement"] = []interface{}{stmt}
+	}
+
+	canonical, err := json.Marshal(doc)
+	if err != nil {
+		return policy
+	}
+	return string(canonical)
 }
 
 func (e *custom) delete(_ context.Context, mg cpresource.Managed) error {
@@ -209,6 +317,76 @@ func (e *custom) delete(_ context.Context, mg cpresource.Managed) error {
 	return err
 }
 
+/*
+update diffs the declared VPCEndpoint against the upstream resource and
+issues a ModifyVpcEndpoint call covering the mutable fields isUpToDate
+checks for: subnets, security groups, route tables, and the policy
+document.
+*/
+func (e *custom) update(ctx context.Context, mg cpresource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.VPCEndpoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	resp, err := e.client.DescribeVpcEndpointsWithContext(ctx, &svcsdk.DescribeVpcEndpointsInput{
+		VpcEndpointIds: []*string{&externalName},
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if len(resp.VpcEndpoints) == 0 {
+		return managed.ExternalUpdate{}, errors.New(errDescribe)
+	}
+
+	input := generateModifyVpcEndpointInput(cr, resp.VpcEndpoints[0])
+	if _, err := e.client.ModifyVpcEndpointWithContext(ctx, input); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+/*
+generateModifyVpcEndpointInput diffs the declared VPCEndpoint against the
+observed upstream VpcEndpoint and builds a ModifyVpcEndpointInput covering
+subnets, security groups, route tables, and the policy document.
+*/
+func generateModifyVpcEndpointInput(cr *svcapitypes.VPCEndpoint, observed *svcsdk.VpcEndpoint) *svcsdk.ModifyVpcEndpointInput {
+	externalName := meta.GetExternalName(cr)
+
+	upstreamSecurityGroupIDs := make([]*string, 0, len(observed.Groups))
+	for _, g := range observed.Groups {
+		upstreamSecurityGroupIDs = append(upstreamSecurityGroupIDs, g.GroupId)
+	}
+
+	input := &svcsdk.ModifyVpcEndpointInput{
+		VpcEndpointId: &externalName,
+
+		AddSubnetIds:    listSubtractFromStringPtr(cr.Spec.ForProvider.SubnetIDs, observed.SubnetIds),
+		RemoveSubnetIds: listSubtractFromStringPtr(observed.SubnetIds, cr.Spec.ForProvider.SubnetIDs),
+
+		AddSecurityGroupIds:    listSubtractFromStringPtr(cr.Spec.ForProvider.SecurityGroupIDs, upstreamSecurityGroupIDs),
+		RemoveSecurityGroupIds: listSubtractFromStringPtr(upstreamSecurityGroupIDs, cr.Spec.ForProvider.SecurityGroupIDs),
+
+		AddRouteTableIds:    listSubtractFromStringPtr(cr.Spec.ForProvider.RouteTableIDs, observed.RouteTableIds),
+		RemoveRouteTableIds: listSubtractFromStringPtr(observed.RouteTableIds, cr.Spec.ForProvider.RouteTableIDs),
+	}
+
+	declaredPolicy := awsclients.StringValue(cr.Spec.ForProvider.PolicyDocument)
+	if !policyIsUpToDate(declaredPolicy, awsclients.StringValue(observed.PolicyDocument)) {
+		if declaredPolicy == "" {
+			input.PolicyDocument = aws.String(defaultVPCEndpointPolicy)
+		} else {
+			input.PolicyDocument = cr.Spec.ForProvider.PolicyDocument
+		}
+	}
+
+	formatModifyVpcEndpointInput(input)
+	return input
+}
+
 func filterList(cr *svcapitypes.VPCEndpoint, obj *svcsdk.DescribeVpcEndpointsOutput) *svcsdk.DescribeVpcEndpointsOutput {
 	connectionIdentifier := aws.String(meta.GetExternalName(cr))
 	resp := &svcsdk.DescribeVpcEndpointsOutput{}
@@ -240,8 +418,6 @@ func generateVPCEndpointSDK(vpcEndpoint *ec2.VpcEndpoint) *svcapitypes.VPCEndpoi
 
 	return vpcEndpointSDK
 }
-<<<<<<< HEAD
-=======
 
 /*
 formatModifyVpcEndpointInput takes in a ModifyVpcEndpointInput, and sets
@@ -326,4 +502,3 @@ compare:
 
 	return true
 }
->>>>>>> 58d44389 (Reduce cyclomatic complexity for isUpToDate)
@@ -0,0 +1,291 @@
+package vpcendpoint
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+)
+
+func strPtrs(values ...string) []*string {
+	out := make([]*string, 0, len(values))
+	for i := range values {
+		out = append(out, &values[i])
+	}
+	return out
+}
+
+func vpcEndpoint(subnets, securityGroups, routeTables []*string, policy string) *svcapitypes.VPCEndpoint {
+	cr := &svcapitypes.VPCEndpoint{}
+	cr.Spec.ForProvider.SubnetIDs = subnets
+	cr.Spec.ForProvider.SecurityGroupIDs = securityGroups
+	cr.Spec.ForProvider.RouteTableIDs = routeTables
+	if policy != "" {
+		cr.Spec.ForProvider.PolicyDocument = aws.String(policy)
+	}
+	return cr
+}
+
+func observedVPCEndpoint(subnets, routeTables []*string, securityGroups []string, policy string) *svcsdk.VpcEndpoint {
+	groups := make([]*svcsdk.SecurityGroupIdentifier, 0, len(securityGroups))
+	for i := range securityGroups {
+		groups = append(groups, &svcsdk.SecurityGroupIdentifier{GroupId: &securityGroups[i]})
+	}
+	return &svcsdk.VpcEndpoint{
+		SubnetIds:      subnets,
+		RouteTableIds:  routeTables,
+		Groups:         groups,
+		PolicyDocument: aws.String(policy),
+	}
+}
+
+func TestGenerateModifyVpcEndpointInput(t *testing.T) {
+	type args struct {
+		cr       *svcapitypes.VPCEndpoint
+		observed *svcsdk.VpcEndpoint
+	}
+	cases := map[string]struct {
+		args args
+		want *svcsdk.ModifyVpcEndpointInput
+	}{
+		"SubnetsAddOnly": {
+			args: args{
+				cr:       vpcEndpoint(strPtrs("subnet-1", "subnet-2"), nil, nil, ""),
+				observed: observedVPCEndpoint(strPtrs("subnet-1"), nil, nil, defaultVPCEndpointPolicy),
+			},
+			want: &svcsdk.ModifyVpcEndpointInput{
+				AddSubnetIds: strPtrs("subnet-2"),
+			},
+		},
+		"SubnetsRemoveOnly": {
+			args: args{
+				cr:       vpcEndpoint(strPtrs("subnet-1"), nil, nil, ""),
+				observed: observedVPCEndpoint(strPtrs("subnet-1", "subnet-2"), nil, nil, defaultVPCEndpointPolicy),
+			},
+			want: &svcsdk.ModifyVpcEndpointInput{
+				RemoveSubnetIds: strPtrs("subnet-2"),
+			},
+		},
+		"SubnetsMixed": {
+			args: args{
+				cr:       vpcEndpoint(strPtrs("subnet-1", "subnet-3"), nil, nil, ""),
+				observed: observedVPCEndpoint(strPtrs("subnet-1", "subnet-2"), nil, nil, defaultVPCEndpointPolicy),
+			},
+			want: &svcsdk.ModifyVpcEndpointInput{
+				AddSubnetIds:    strPtrs("subnet-3"),
+				RemoveSubnetIds: strPtrs("subnet-2"),
+			},
+		},
+		"SecurityGroupsAddOnly": {
+			args: args{
+				cr:       vpcEndpoint(nil, strPtrs("sg-1", "sg-2"), nil, ""),
+				observed: observedVPCEndpoint(nil, nil, []string{"sg-1"}, defaultVPCEndpointPolicy),
+			},
+			want: &svcsdk.ModifyVpcEndpointInput{
+				AddSecurityGroupIds: strPtrs("sg-2"),
+			},
+		},
+		"SecurityGroupsRemoveOnly": {
+			args: args{
+				cr:       vpcEndpoint(nil, strPtrs("sg-1"), nil, ""),
+				observed: observedVPCEndpoint(nil, nil, []string{"sg-1", "sg-2"}, defaultVPCEndpointPolicy),
+			},
+			want: &svcsdk.ModifyVpcEndpointInput{
+				RemoveSecurityGroupIds: strPtrs("sg-2"),
+			},
+		},
+		"SecurityGroupsMixed": {
+			args: args{
+				cr:       vpcEndpoint(nil, strPtrs("sg-1", "sg-3"), nil, ""),
+				observed: observedVPCEndpoint(nil, nil, []string{"sg-1", "sg-2"}, defaultVPCEndpointPolicy),
+			},
+			want: &svcsdk.ModifyVpcEndpointInput{
+				AddSecurityGroupIds:    strPtrs("sg-3"),
+				RemoveSecurityGroupIds: strPtrs("sg-2"),
+			},
+		},
+		"RouteTablesAddOnly": {
+			args: args{
+				cr:       vpcEndpoint(nil, nil, strPtrs("rtb-1", "rtb-2"), ""),
+				observed: observedVPCEndpoint(nil, strPtrs("rtb-1"), nil, defaultVPCEndpointPolicy),
+			},
+			want: &svcsdk.ModifyVpcEndpointInput{
+				AddRouteTableIds: strPtrs("rtb-2"),
+			},
+		},
+		"RouteTablesRemoveOnly": {
+			args: args{
+				cr:       vpcEndpoint(nil, nil, strPtrs("rtb-1"), ""),
+				observed: observedVPCEndpoint(nil, strPtrs("rtb-1", "rtb-2"), nil, defaultVPCEndpointPolicy),
+			},
+			want: &svcsdk.ModifyVpcEndpointInput{
+				RemoveRouteTableIds: strPtrs("rtb-2"),
+			},
+		},
+		"RouteTablesMixed": {
+			args: args{
+				cr:       vpcEndpoint(nil, nil, strPtrs("rtb-1", "rtb-3"), ""),
+				observed: observedVPCEndpoint(nil, strPtrs("rtb-1", "rtb-2"), nil, defaultVPCEndpointPolicy),
+			},
+			want: &svcsdk.ModifyVpcEndpointInput{
+				AddRouteTableIds:    strPtrs("rtb-3"),
+				RemoveRouteTableIds: strPtrs("rtb-2"),
+			},
+		},
+		"PolicyOnlyChange": {
+			args: args{
+				cr:       vpcEndpoint(strPtrs("subnet-1"), nil, nil, `{"Statement":[{"Action":"s3:GetObject"}]}`),
+				observed: observedVPCEndpoint(strPtrs("subnet-1"), nil, nil, defaultVPCEndpointPolicy),
+			},
+			want: &svcsdk.ModifyVpcEndpointInput{
+				PolicyDocument: aws.String(`{"Statement":[{"Action":"s3:GetObject"}]}`),
+			},
+		},
+		"PolicyEquivalentNoUpdate": {
+			args: args{
+				cr:       vpcEndpoint(strPtrs("subnet-1"), nil, nil, `{ "Statement": { "Action": "s3:GetObject" } }`),
+				observed: observedVPCEndpoint(strPtrs("subnet-1"), nil, nil, `{"Statement":[{"Action":"s3:GetObject"}]}`),
+			},
+			want: &svcsdk.ModifyVpcEndpointInput{},
+		},
+		"NoDeclaredPolicyDriftedFromDefault": {
+			args: args{
+				cr:       vpcEndpoint(strPtrs("subnet-1"), nil, nil, ""),
+				observed: observedVPCEndpoint(strPtrs("subnet-1"), nil, nil, `{"Statement":[{"Action":"s3:GetObject"}]}`),
+			},
+			want: &svcsdk.ModifyVpcEndpointInput{
+				PolicyDocument: aws.String(defaultVPCEndpointPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.want.VpcEndpointId = aws.String("")
+			got := generateModifyVpcEndpointInput(tc.args.cr, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(svcsdk.ModifyVpcEndpointInput{})); diff != "" {
+				t.Errorf("generateModifyVpcEndpointInput(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func dnsEntry(dnsName, hostedZoneID string) *svcsdk.DnsEntry {
+	return &svcsdk.DnsEntry{DnsName: aws.String(dnsName), HostedZoneId: aws.String(hostedZoneID)}
+}
+
+func TestConnectionDetails(t *testing.T) {
+	type args struct {
+		cr   *svcapitypes.VPCEndpoint
+		vpce *svcsdk.VpcEndpoint
+	}
+	cases := map[string]struct {
+		args args
+		want managed.ConnectionDetails
+	}{
+		"RegionalAndZonalEntries": {
+			args: args{
+				cr: &svcapitypes.VPCEndpoint{},
+				vpce: &svcsdk.VpcEndpoint{
+					ServiceName: aws.String("com.amazonaws.us-east-1.s3"),
+					DnsEntries: []*svcsdk.DnsEntry{
+						dnsEntry("vpce-123-abc.vpce-svc-456.us-east-1.vpce.amazonaws.com", "Z1REGIONAL"),
+						dnsEntry("vpce-123-abc-us-east-1a.vpce-svc-456.us-east-1.vpce.amazonaws.com", "Z1ZONEA"),
+						dnsEntry("vpce-123-abc-us-east-1b.vpce-svc-456.us-east-1.vpce.amazonaws.com", "Z1ZONEB"),
+					},
+				},
+			},
+			want: managed.ConnectionDetails{
+				"endpoint":                []byte("vpce-123-abc.vpce-svc-456.us-east-1.vpce.amazonaws.com"),
+				"hostedZoneID":             []byte("Z1REGIONAL"),
+				"endpoint.us-east-1a":      []byte("vpce-123-abc-us-east-1a.vpce-svc-456.us-east-1.vpce.amazonaws.com"),
+				"hostedZoneID.us-east-1a":  []byte("Z1ZONEA"),
+				"endpoint.us-east-1b":      []byte("vpce-123-abc-us-east-1b.vpce-svc-456.us-east-1.vpce.amazonaws.com"),
+				"hostedZoneID.us-east-1b":  []byte("Z1ZONEB"),
+				"serviceName":              []byte("com.amazonaws.us-east-1.s3"),
+			},
+		},
+		"OrderIndependentKeying": {
+			args: args{
+				cr: &svcapitypes.VPCEndpoint{},
+				vpce: &svcsdk.VpcEndpoint{
+					DnsEntries: []*svcsdk.DnsEntry{
+						dnsEntry("vpce-123-abc-us-east-1b.vpce-svc-456.us-east-1.vpce.amazonaws.com", "Z1ZONEB"),
+						dnsEntry("vpce-123-abc-us-east-1a.vpce-svc-456.us-east-1.vpce.amazonaws.com", "Z1ZONEA"),
+					},
+				},
+			},
+			want: managed.ConnectionDetails{
+				"endpoint.us-east-1a":     []byte("vpce-123-abc-us-east-1a.vpce-svc-456.us-east-1.vpce.amazonaws.com"),
+				"hostedZoneID.us-east-1a": []byte("Z1ZONEA"),
+				"endpoint.us-east-1b":     []byte("vpce-123-abc-us-east-1b.vpce-svc-456.us-east-1.vpce.amazonaws.com"),
+				"hostedZoneID.us-east-1b": []byte("Z1ZONEB"),
+			},
+		},
+		"PrivateDNSSkipsLeadingEmptyEntry": {
+			args: args{
+				cr: &svcapitypes.VPCEndpoint{},
+				vpce: &svcsdk.VpcEndpoint{
+					PrivateDnsEnabled: aws.Bool(true),
+					DnsEntries: []*svcsdk.DnsEntry{
+						{DnsName: aws.String(""), HostedZoneId: aws.String("")},
+						dnsEntry("vpce-123-abc.vpce-svc-456.us-east-1.vpce.amazonaws.com", "Z1REGIONAL"),
+					},
+				},
+			},
+			want: managed.ConnectionDetails{
+				"endpoint":       []byte("vpce-123-abc.vpce-svc-456.us-east-1.vpce.amazonaws.com"),
+				"hostedZoneID":   []byte("Z1REGIONAL"),
+				"privateDnsName": []byte("vpce-123-abc.vpce-svc-456.us-east-1.vpce.amazonaws.com"),
+			},
+		},
+		"GovCloudPartitionAZ": {
+			args: args{
+				cr: &svcapitypes.VPCEndpoint{},
+				vpce: &svcsdk.VpcEndpoint{
+					DnsEntries: []*svcsdk.DnsEntry{
+						dnsEntry("vpce-123-abc-us-gov-west-1a.vpce-svc-456.us-gov-west-1.vpce.amazonaws.com", "Z1GOVZONEA"),
+					},
+				},
+			},
+			want: managed.ConnectionDetails{
+				"endpoint.us-gov-west-1a":     []byte("vpce-123-abc-us-gov-west-1a.vpce-svc-456.us-gov-west-1.vpce.amazonaws.com"),
+				"hostedZoneID.us-gov-west-1a": []byte("Z1GOVZONEA"),
+			},
+		},
+		"KeyPrefixNamespacesEveryKey": {
+			args: args{
+				cr: func() *svcapitypes.VPCEndpoint {
+					cr := &svcapitypes.VPCEndpoint{}
+					cr.Spec.ForProvider.ConnectionDetailKeyPrefix = aws.String("s3.")
+					return cr
+				}(),
+				vpce: &svcsdk.VpcEndpoint{
+					ServiceName: aws.String("com.amazonaws.us-east-1.s3"),
+					DnsEntries: []*svcsdk.DnsEntry{
+						dnsEntry("vpce-123-abc.vpce-svc-456.us-east-1.vpce.amazonaws.com", "Z1REGIONAL"),
+					},
+				},
+			},
+			want: managed.ConnectionDetails{
+				"s3.endpoint":     []byte("vpce-123-abc.vpce-svc-456.us-east-1.vpce.amazonaws.com"),
+				"s3.hostedZoneID": []byte("Z1REGIONAL"),
+				"s3.serviceName":  []byte("com.amazonaws.us-east-1.s3"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := connectionDetails(tc.args.cr, tc.args.vpce)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("connectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+This file hand-maintains the connector/external scaffolding that
+ack-generate produces for other resources in this provider (see e.g. the
+vpcendpoint package). No VPCPeeringConnection generator run has been
+produced for this repo yet, so this is written to match that output by
+hand; once ack-generate is run against this resource, this file should be
+replaced with its real output and diffed for drift.
+*/
+
+package vpcpeeringconnection
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/ec2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	cpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a VPCPeeringConnection resource"
+
+	errCreateSession = "cannot create a new session"
+	errCreate        = "cannot create VPCPeeringConnection in AWS"
+	errDescribe      = "cannot describe VPCPeeringConnection in AWS"
+	errDelete        = "cannot delete VPCPeeringConnection in AWS"
+	errUpdate        = "cannot update VPCPeeringConnection in AWS"
+	errAccept        = "cannot accept VPCPeeringConnection in AWS"
+)
+
+type connector struct {
+	kube client.Client
+	opts []option
+}
+
+// option configures the external client returned by connector.Connect.
+type option func(*external)
+
+func (c *connector) Connect(ctx context.Context, mg cpresource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.VPCPeeringConnection)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclients.GetConfigV1(ctx, c.kube, cr, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	e := &external{kube: c.kube, client: svcsdk.New(sess)}
+	for _, o := range c.opts {
+		o(e)
+	}
+	return e, nil
+}
+
+type external struct {
+	kube   client.Client
+	client svcsdkapi.EC2API
+
+	preCreate   func(context.Context, *svcapitypes.VPCPeeringConnection, *svcsdk.CreateVpcPeeringConnectionInput) error
+	postCreate  func(context.Context, *svcapitypes.VPCPeeringConnection, *svcsdk.CreateVpcPeeringConnectionOutput, managed.ExternalCreation, error) (managed.ExternalCreation, error)
+	preObserve  func(context.Context, *svcapitypes.VPCPeeringConnection, *svcsdk.DescribeVpcPeeringConnectionsInput) error
+	postObserve func(context.Context, *svcapitypes.VPCPeeringConnection, *svcsdk.DescribeVpcPeeringConnectionsOutput, managed.ExternalObservation, error) (managed.ExternalObservation, error)
+	isUpToDate  func(context.Context, *svcapitypes.VPCPeeringConnection, *svcsdk.DescribeVpcPeeringConnectionsOutput) (bool, error)
+	preUpdate   func(context.Context, *svcapitypes.VPCPeeringConnection, *svcsdk.DescribeVpcPeeringConnectionsOutput) error
+	delete      func(context.Context, cpresource.Managed) error
+}
+
+func (e *external) Observe(ctx context.Context, mg cpresource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.VPCPeeringConnection)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	input := &svcsdk.DescribeVpcPeeringConnectionsInput{
+		VpcPeeringConnectionIds: []*string{aws.String(meta.GetExternalName(cr))},
+	}
+	if e.preObserve != nil {
+		if err := e.preObserve(ctx, cr, input); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
+	resp, err := e.client.DescribeVpcPeeringConnections(input)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(cpresource.Ignore(isNotFound, err), errDescribe)
+	}
+	if len(resp.VpcPeeringConnections) == 0 {
+		return managed.ExternalObservation{}, nil
+	}
+
+	upToDate, err := true, error(nil)
+	if e.isUpToDate != nil {
+		upToDate, err = e.isUpToDate(ctx, cr, resp)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
+	obs := managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}
+	if e.postObserve != nil {
+		return e.postObserve(ctx, cr, resp, obs, nil)
+	}
+	return obs, nil
+}
+
+func (e *external) Create(ctx context.Context, mg cpresource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.VPCPeeringConnection)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	input := &svcsdk.CreateVpcPeeringConnectionInput{}
+	if e.preCreate != nil {
+		if err := e.preCreate(ctx, cr, input); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+		}
+	}
+
+	resp, err := e.client.CreateVpcPeeringConnection(input)
+	if e.postCreate != nil {
+		return e.postCreate(ctx, cr, resp, managed.ExternalCreation{}, errors.Wrap(err, errCreate))
+	}
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mg cpresource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.VPCPeeringConnection)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	resp, err := e.client.DescribeVpcPeeringConnections(&svcsdk.DescribeVpcPeeringConnectionsInput{
+		VpcPeeringConnectionIds: []*string{aws.String(meta.GetExternalName(cr))},
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if len(resp.VpcPeeringConnections) == 0 {
+		return managed.ExternalUpdate{}, errors.New(errDescribe)
+	}
+
+	if e.preUpdate != nil {
+		if err := e.preUpdate(ctx, cr, resp); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg cpresource.Managed) error {
+	if e.delete != nil {
+		return errors.Wrap(e.delete(ctx, mg), errDelete)
+	}
+	return nil
+}
+
+func isNotFound(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code() == "InvalidVpcPeeringConnectionID.NotFound"
+	}
+	return false
+}
@@ -0,0 +1,252 @@
+package vpcpeeringconnection
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/ec2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	cpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupVPCPeeringConnection adds a controller that reconciles VPCPeeringConnection.
+func SetupVPCPeeringConnection(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, enableManagementPolicies bool) error {
+	name := managed.ControllerName(svcapitypes.VPCPeeringConnectionGroupKind)
+	opts := []option{
+		func(e *external) {
+			c := &custom{client: e.client, kube: e.kube}
+			e.delete = c.delete
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.postObserve = c.postObserve
+			e.isUpToDate = isUpToDate
+			e.preUpdate = c.preUpdate
+		},
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		managed.WithPollInterval(poll),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+	if enableManagementPolicies {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&svcapitypes.VPCPeeringConnection{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.VPCPeeringConnectionGroupVersionKind),
+			reconcilerOpts...))
+}
+
+type custom struct {
+	kube   client.Client
+	client svcsdkapi.EC2API
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.VPCPeeringConnection, obj *svcsdk.CreateVpcPeeringConnectionInput) error {
+	obj.VpcId = cr.Spec.ForProvider.VPCID
+	obj.PeerVpcId = cr.Spec.ForProvider.PeerVPCID
+	obj.PeerRegion = cr.Spec.ForProvider.PeerRegion
+	obj.PeerOwnerId = cr.Spec.ForProvider.PeerOwnerID
+
+	// set external name as tag on the vpc peering connection
+	resType := "vpc-peering-connection"
+	key := "Name"
+	value := meta.GetExternalName(cr)
+
+	obj.TagSpecifications = append(obj.TagSpecifications, &svcsdk.TagSpecification{
+		ResourceType: &resType,
+		Tags: []*svcsdk.Tag{
+			{
+				Key:   &key,
+				Value: &value,
+			},
+		},
+	})
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.VPCPeeringConnection, obj *svcsdk.CreateVpcPeeringConnectionOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil || obj.VpcPeeringConnection == nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	// set vpc peering connection id as external name annotation on k8s object after creation
+	meta.SetExternalName(cr, aws.StringValue(obj.VpcPeeringConnection.VpcPeeringConnectionId))
+	cre.ExternalNameAssigned = true
+	return cre, nil
+}
+
+/*
+postObserve maps the upstream VpcPeeringConnection status onto the managed
+resource's conditions, and - when AcceptRequest is set - accepts a
+pending-acceptance request on the requester's behalf.
+*/
+func (c *custom) postObserve(ctx context.Context, cr *svcapitypes.VPCPeeringConnection, resp *svcsdk.DescribeVpcPeeringConnectionsOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	pc := resp.VpcPeeringConnections[0]
+	if pc.Status == nil {
+		return obs, nil
+	}
+	cr.Status.AtProvider.Status = pc.Status.Code
+
+	switch awsclients.StringValue(pc.Status.Code) {
+	case "initiating-request", "provisioning":
+		cr.SetConditions(xpv1.Creating())
+	case "pending-acceptance":
+		if aws.BoolValue(cr.Spec.ForProvider.AcceptRequest) && acceptAllowed(cr, pc) {
+			if _, err := c.client.AcceptVpcPeeringConnectionWithContext(ctx, &svcsdk.AcceptVpcPeeringConnectionInput{
+				VpcPeeringConnectionId: pc.VpcPeeringConnectionId,
+			}); err != nil {
+				return obs, errors.Wrap(err, errAccept)
+			}
+		}
+		cr.SetConditions(xpv1.Creating())
+	case "active":
+		cr.SetConditions(xpv1.Available())
+	case "deleting":
+		cr.SetConditions(xpv1.Deleting())
+	case "deleted", "rejected", "failed", "expired":
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	return obs, nil
+}
+
+/*
+acceptAllowed reports whether this controller is entitled to accept a
+pending-acceptance connection. AcceptVpcPeeringConnection can only be
+called with the credentials of the accepter VPC's owner, so a same-account
+connection is always safe to auto-accept; a cross-account one is only
+safe when the operator has explicitly opted in via
+AcceptCrossAccountRequest (e.g. because the provider is also configured
+with the accepter account's credentials).
+*/
+func acceptAllowed(cr *svcapitypes.VPCPeeringConnection, pc *svcsdk.VpcPeeringConnection) bool {
+	sameAccount := pc.RequesterVpcInfo != nil && pc.AccepterVpcInfo != nil &&
+		awsclients.StringValue(pc.RequesterVpcInfo.OwnerId) == awsclients.StringValue(pc.AccepterVpcInfo.OwnerId)
+	return sameAccount || aws.BoolValue(cr.Spec.ForProvider.AcceptCrossAccountRequest)
+}
+
+/*
+isUpToDate checks whether the declared AccepterPeeringOptions and
+RequesterPeeringOptions (DNS resolution and classic link) match what AWS
+reports for the VpcPeeringConnection.
+*/
+func isUpToDate(_ context.Context, cr *svcapitypes.VPCPeeringConnection, obj *svcsdk.DescribeVpcPeeringConnectionsOutput) (bool, error) {
+	pc := obj.VpcPeeringConnections[0]
+
+	if !peeringOptionsUpToDate(cr.Spec.ForProvider.AccepterPeeringOptions, peeringOptionsOf(pc.AccepterVpcInfo)) {
+		return false, nil
+	}
+	if !peeringOptionsUpToDate(cr.Spec.ForProvider.RequesterPeeringOptions, peeringOptionsOf(pc.RequesterVpcInfo)) {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func peeringOptionsOf(info *svcsdk.VpcPeeringConnectionVpcInfo) *svcsdk.VpcPeeringConnectionOptionsDescription {
+	if info == nil {
+		return nil
+	}
+	return info.PeeringOptions
+}
+
+func peeringOptionsUpToDate(declared *svcapitypes.VPCPeeringConnectionOptions, observed *svcsdk.VpcPeeringConnectionOptionsDescription) bool {
+	if declared == nil {
+		return true
+	}
+	if observed == nil {
+		return false
+	}
+
+	if declared.AllowDNSResolutionFromRemoteVPC != nil &&
+		aws.BoolValue(declared.AllowDNSResolutionFromRemoteVPC) != aws.BoolValue(observed.AllowDnsResolutionFromRemoteVpc) {
+		return false
+	}
+	if declared.AllowEgressFromLocalClassicLinkToRemoteVPC != nil &&
+		aws.BoolValue(declared.AllowEgressFromLocalClassicLinkToRemoteVPC) != aws.BoolValue(observed.AllowEgressFromLocalClassicLinkToRemoteVpc) {
+		return false
+	}
+
+	return true
+}
+
+/*
+preUpdate builds a ModifyVpcPeeringConnectionOptionsInput from whichever
+side(s) of the connection have drifted and issues the call. This is the
+entirety of what can be updated on a VpcPeeringConnection, so there is no
+further work for the generic Update path to do.
+*/
+func (e *custom) preUpdate(ctx context.Context, cr *svcapitypes.VPCPeeringConnection, obj *svcsdk.DescribeVpcPeeringConnectionsOutput) error {
+	pc := obj.VpcPeeringConnections[0]
+	input := &svcsdk.ModifyVpcPeeringConnectionOptionsInput{
+		VpcPeeringConnectionId: pc.VpcPeeringConnectionId,
+	}
+
+	if !peeringOptionsUpToDate(cr.Spec.ForProvider.AccepterPeeringOptions, peeringOptionsOf(pc.AccepterVpcInfo)) {
+		input.AccepterPeeringConnectionOptions = toPeeringConnectionOptionsRequest(cr.Spec.ForProvider.AccepterPeeringOptions)
+	}
+	if !peeringOptionsUpToDate(cr.Spec.ForProvider.RequesterPeeringOptions, peeringOptionsOf(pc.RequesterVpcInfo)) {
+		input.RequesterPeeringConnectionOptions = toPeeringConnectionOptionsRequest(cr.Spec.ForProvider.RequesterPeeringOptions)
+	}
+
+	if input.AccepterPeeringConnectionOptions == nil && input.RequesterPeeringConnectionOptions == nil {
+		return nil
+	}
+
+	_, err := e.client.ModifyVpcPeeringConnectionOptionsWithContext(ctx, input)
+	return err
+}
+
+func toPeeringConnectionOptionsRequest(o *svcapitypes.VPCPeeringConnectionOptions) *svcsdk.PeeringConnectionOptionsRequest {
+	if o == nil {
+		return nil
+	}
+	return &svcsdk.PeeringConnectionOptionsRequest{
+		AllowDnsResolutionFromRemoteVpc:            o.AllowDNSResolutionFromRemoteVPC,
+		AllowEgressFromLocalClassicLinkToRemoteVpc: o.AllowEgressFromLocalClassicLinkToRemoteVPC,
+	}
+}
+
+func (e *custom) delete(_ context.Context, mg cpresource.Managed) error {
+	cr, ok := mg.(*svcapitypes.VPCPeeringConnection)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	_, err := e.client.DeleteVpcPeeringConnection(&svcsdk.DeleteVpcPeeringConnectionInput{
+		VpcPeeringConnectionId: &externalName,
+	})
+	return err
+}
@@ -0,0 +1,179 @@
+package vpcpeeringconnection
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+)
+
+func peeringOptions(dnsResolution, classicLink *bool) *svcapitypes.VPCPeeringConnectionOptions {
+	if dnsResolution == nil && classicLink == nil {
+		return nil
+	}
+	return &svcapitypes.VPCPeeringConnectionOptions{
+		AllowDNSResolutionFromRemoteVPC:            dnsResolution,
+		AllowEgressFromLocalClassicLinkToRemoteVPC: classicLink,
+	}
+}
+
+func observedPeeringOptions(dnsResolution, classicLink *bool) *svcsdk.VpcPeeringConnectionOptionsDescription {
+	return &svcsdk.VpcPeeringConnectionOptionsDescription{
+		AllowDnsResolutionFromRemoteVpc:            dnsResolution,
+		AllowEgressFromLocalClassicLinkToRemoteVpc: classicLink,
+	}
+}
+
+func TestPeeringOptionsUpToDate(t *testing.T) {
+	type args struct {
+		declared *svcapitypes.VPCPeeringConnectionOptions
+		observed *svcsdk.VpcPeeringConnectionOptionsDescription
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NoDeclaredOptions": {
+			args: args{
+				declared: nil,
+				observed: observedPeeringOptions(aws.Bool(true), aws.Bool(false)),
+			},
+			want: true,
+		},
+		"NoObservedOptions": {
+			args: args{
+				declared: peeringOptions(aws.Bool(true), nil),
+				observed: nil,
+			},
+			want: false,
+		},
+		"DNSResolutionMatches": {
+			args: args{
+				declared: peeringOptions(aws.Bool(true), nil),
+				observed: observedPeeringOptions(aws.Bool(true), aws.Bool(false)),
+			},
+			want: true,
+		},
+		"DNSResolutionDrifted": {
+			args: args{
+				declared: peeringOptions(aws.Bool(true), nil),
+				observed: observedPeeringOptions(aws.Bool(false), aws.Bool(false)),
+			},
+			want: false,
+		},
+		"ClassicLinkDrifted": {
+			args: args{
+				declared: peeringOptions(nil, aws.Bool(true)),
+				observed: observedPeeringOptions(aws.Bool(false), aws.Bool(false)),
+			},
+			want: false,
+		},
+		"BothMatch": {
+			args: args{
+				declared: peeringOptions(aws.Bool(true), aws.Bool(true)),
+				observed: observedPeeringOptions(aws.Bool(true), aws.Bool(true)),
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := peeringOptionsUpToDate(tc.args.declared, tc.args.observed)
+			if got != tc.want {
+				t.Errorf("peeringOptionsUpToDate(...): got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestToPeeringConnectionOptionsRequest(t *testing.T) {
+	cases := map[string]struct {
+		in   *svcapitypes.VPCPeeringConnectionOptions
+		want *svcsdk.PeeringConnectionOptionsRequest
+	}{
+		"Nil": {
+			in:   nil,
+			want: nil,
+		},
+		"Populated": {
+			in: peeringOptions(aws.Bool(true), aws.Bool(false)),
+			want: &svcsdk.PeeringConnectionOptionsRequest{
+				AllowDnsResolutionFromRemoteVpc:            aws.Bool(true),
+				AllowEgressFromLocalClassicLinkToRemoteVpc: aws.Bool(false),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := toPeeringConnectionOptionsRequest(tc.in)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(svcsdk.PeeringConnectionOptionsRequest{})); diff != "" {
+				t.Errorf("toPeeringConnectionOptionsRequest(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func vpcInfo(ownerID string) *svcsdk.VpcPeeringConnectionVpcInfo {
+	return &svcsdk.VpcPeeringConnectionVpcInfo{OwnerId: aws.String(ownerID)}
+}
+
+func TestAcceptAllowed(t *testing.T) {
+	type args struct {
+		cr *svcapitypes.VPCPeeringConnection
+		pc *svcsdk.VpcPeeringConnection
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SameAccountAlwaysAllowed": {
+			args: args{
+				cr: &svcapitypes.VPCPeeringConnection{},
+				pc: &svcsdk.VpcPeeringConnection{
+					RequesterVpcInfo: vpcInfo("111111111111"),
+					AccepterVpcInfo:  vpcInfo("111111111111"),
+				},
+			},
+			want: true,
+		},
+		"CrossAccountRequiresOptIn": {
+			args: args{
+				cr: &svcapitypes.VPCPeeringConnection{},
+				pc: &svcsdk.VpcPeeringConnection{
+					RequesterVpcInfo: vpcInfo("111111111111"),
+					AccepterVpcInfo:  vpcInfo("222222222222"),
+				},
+			},
+			want: false,
+		},
+		"CrossAccountAllowedWhenOptedIn": {
+			args: args{
+				cr: func() *svcapitypes.VPCPeeringConnection {
+					cr := &svcapitypes.VPCPeeringConnection{}
+					cr.Spec.ForProvider.AcceptCrossAccountRequest = aws.Bool(true)
+					return cr
+				}(),
+				pc: &svcsdk.VpcPeeringConnection{
+					RequesterVpcInfo: vpcInfo("111111111111"),
+					AccepterVpcInfo:  vpcInfo("222222222222"),
+				},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := acceptAllowed(tc.args.cr, tc.args.pc)
+			if got != tc.want {
+				t.Errorf("acceptAllowed(...): got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}